@@ -0,0 +1,39 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+// Config stores the provider-level settings gathered from the provider
+// block's schema before a client is built from them.
+type Config struct {
+	IamParallelism int
+}
+
+// AWSClient holds the service connections shared by this provider's
+// resources, along with the provider-level tunables that affect how
+// they're used.
+type AWSClient struct {
+	iamconn *iam.IAM
+
+	// iamParallelism bounds how many IAM attach/detach calls a single
+	// resource operation (e.g. attaching a policy to hundreds of users)
+	// will issue concurrently.
+	iamParallelism int
+}
+
+// Client builds an AWSClient from the provider configuration.
+func (c *Config) Client() (interface{}, error) {
+	sess := session.Must(session.NewSession())
+
+	parallelism := c.IamParallelism
+	if parallelism <= 0 {
+		parallelism = 10
+	}
+
+	return &AWSClient{
+		iamconn:        iam.New(sess),
+		iamParallelism: parallelism,
+	}, nil
+}
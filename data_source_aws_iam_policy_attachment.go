@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsIamPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIamPolicyAttachmentRead,
+
+		Schema: map[string]*schema.Schema{
+			"policy_arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"entity_filter": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					validValues := map[string]bool{
+						"User":               true,
+						"Role":               true,
+						"Group":              true,
+						"LocalManagedPolicy": true,
+						"AWSManagedPolicy":   true,
+					}
+					if !validValues[value] {
+						errors = append(errors, fmt.Errorf("%q must be one of User, Role, Group, LocalManagedPolicy, or AWSManagedPolicy, got: %s", k, value))
+					}
+					return
+				},
+			},
+			"path_prefix": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"users": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"roles": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"groups": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"attachment_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsIamPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	arn := d.Get("policy_arn").(string)
+
+	input := &iam.ListEntitiesForPolicyInput{
+		PolicyARN: aws.String(arn),
+	}
+	if v, ok := d.GetOk("entity_filter"); ok {
+		input.EntityFilter = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("path_prefix"); ok {
+		input.PathPrefix = aws.String(v.(string))
+	}
+
+	var users, roles, groups []string
+	for {
+		out, err := conn.ListEntitiesForPolicy(input)
+		if err != nil {
+			return fmt.Errorf("Error listing entities for IAM policy (%s): %s", arn, err)
+		}
+
+		for _, u := range out.PolicyUsers {
+			users = append(users, *u.UserName)
+		}
+		for _, r := range out.PolicyRoles {
+			roles = append(roles, *r.RoleName)
+		}
+		for _, g := range out.PolicyGroups {
+			groups = append(groups, *g.GroupName)
+		}
+
+		if out.IsTruncated == nil || !*out.IsTruncated {
+			break
+		}
+		input.Marker = out.Marker
+	}
+
+	d.SetId(arn)
+	if err := d.Set("users", users); err != nil {
+		return err
+	}
+	if err := d.Set("roles", roles); err != nil {
+		return err
+	}
+	if err := d.Set("groups", groups); err != nil {
+		return err
+	}
+	d.Set("attachment_count", len(users)+len(roles)+len(groups))
+
+	return nil
+}
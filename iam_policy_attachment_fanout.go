@@ -0,0 +1,162 @@
+package aws
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+// iamFanoutMaxRetries bounds the number of attempts a single attach/detach
+// call gets before its throttling error is surfaced to the caller.
+const iamFanoutMaxRetries = 5
+
+// iamRetryableErrorCodes are the IAM error codes worth backing off and
+// retrying rather than failing the whole fan-out immediately.
+var iamRetryableErrorCodes = map[string]bool{
+	"Throttling":           true,
+	"ThrottlingException":  true,
+	"RequestLimitExceeded": true,
+	"ServiceUnavailable":   true,
+}
+
+func attachPolicyToUsers(conn *iam.IAM, users []*string, arn string, parallelism int) error {
+	return iamFanOut(parallelism, users, func(u *string) error {
+		_, err := conn.AttachUserPolicy(&iam.AttachUserPolicyInput{
+			UserName:  u,
+			PolicyARN: aws.String(arn),
+		})
+		return err
+	})
+}
+
+func attachPolicyToRoles(conn *iam.IAM, roles []*string, arn string, parallelism int) error {
+	return iamFanOut(parallelism, roles, func(r *string) error {
+		_, err := conn.AttachRolePolicy(&iam.AttachRolePolicyInput{
+			RoleName:  r,
+			PolicyARN: aws.String(arn),
+		})
+		return err
+	})
+}
+
+func attachPolicyToGroups(conn *iam.IAM, groups []*string, arn string, parallelism int) error {
+	return iamFanOut(parallelism, groups, func(g *string) error {
+		_, err := conn.AttachGroupPolicy(&iam.AttachGroupPolicyInput{
+			GroupName: g,
+			PolicyARN: aws.String(arn),
+		})
+		return err
+	})
+}
+
+func detachPolicyFromUsers(conn *iam.IAM, users []*string, arn string, parallelism int) error {
+	return iamFanOut(parallelism, users, func(u *string) error {
+		_, err := conn.DetachUserPolicy(&iam.DetachUserPolicyInput{
+			UserName:  u,
+			PolicyARN: aws.String(arn),
+		})
+		return err
+	})
+}
+
+func detachPolicyFromRoles(conn *iam.IAM, roles []*string, arn string, parallelism int) error {
+	return iamFanOut(parallelism, roles, func(r *string) error {
+		_, err := conn.DetachRolePolicy(&iam.DetachRolePolicyInput{
+			RoleName:  r,
+			PolicyARN: aws.String(arn),
+		})
+		return err
+	})
+}
+
+func detachPolicyFromGroups(conn *iam.IAM, groups []*string, arn string, parallelism int) error {
+	return iamFanOut(parallelism, groups, func(g *string) error {
+		_, err := conn.DetachGroupPolicy(&iam.DetachGroupPolicyInput{
+			GroupName: g,
+			PolicyARN: aws.String(arn),
+		})
+		return err
+	})
+}
+
+// iamFanOut runs fn for every item over a worker pool bounded to
+// parallelism concurrent calls, retrying each call on its own when IAM
+// throttles it. Every per-item error is collected and composed into a
+// single error rather than aborting on the first failure, so principals
+// that did succeed are still reflected by the caller's subsequent Read.
+func iamFanOut(parallelism int, items []*string, fn func(*string) error) error {
+	if parallelism <= 0 {
+		parallelism = 10
+	}
+
+	sem := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	errs := make([]error, len(items))
+
+	for i, item := range items {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, item *string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = iamRetry(func() error { return fn(item) })
+		}(i, item)
+	}
+
+	wg.Wait()
+
+	return composeErrors(errs)
+}
+
+// iamRetry retries f with exponential backoff and jitter while it keeps
+// failing with a retryable IAM error code, giving up after
+// iamFanoutMaxRetries attempts.
+func iamRetry(f func() error) error {
+	var err error
+	for attempt := 0; attempt < iamFanoutMaxRetries; attempt++ {
+		err = f()
+		if err == nil {
+			return nil
+		}
+		if !isIamThrottlingError(err) {
+			return err
+		}
+
+		backoff := time.Duration(1<<uint(attempt)) * 200 * time.Millisecond
+		jitter := time.Duration(rand.Int63n(int64(backoff)))
+		time.Sleep(backoff + jitter)
+	}
+	return err
+}
+
+func isIamThrottlingError(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+	return iamRetryableErrorCodes[awsErr.Code()]
+}
+
+// composeErrors joins every non-nil error into a single error so an
+// operator can see all of the principals that failed in one apply
+// instead of just the first one encountered.
+func composeErrors(errs []error) error {
+	var messages []string
+	for _, err := range errs {
+		if err != nil {
+			messages = append(messages, err.Error())
+		}
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%d error(s) occurred:\n* %s", len(messages), strings.Join(messages, "\n* "))
+}
@@ -0,0 +1,41 @@
+package aws
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Provider returns a terraform.ResourceProvider.
+func Provider() terraform.ResourceProvider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"iam_parallelism": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     10,
+				Description: "Maximum number of concurrent IAM attach/detach calls a single resource operation will issue.",
+			},
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_iam_policy_attachment":       resourceAwsIamPolicyAttachment(),
+			"aws_iam_user_policy_attachment":  resourceAwsIamUserPolicyAttachment(),
+			"aws_iam_role_policy_attachment":  resourceAwsIamRolePolicyAttachment(),
+			"aws_iam_group_policy_attachment": resourceAwsIamGroupPolicyAttachment(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"aws_iam_policy_attachment": dataSourceAwsIamPolicyAttachment(),
+		},
+
+		ConfigureFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	config := Config{
+		IamParallelism: d.Get("iam_parallelism").(int),
+	}
+
+	return config.Client()
+}
@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsIamGroupPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIamGroupPolicyAttachmentCreate,
+		Read:   resourceAwsIamGroupPolicyAttachmentRead,
+		Delete: resourceAwsIamGroupPolicyAttachmentDelete,
+
+		Schema: map[string]*schema.Schema{
+			"group": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy_arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIamGroupPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	group := d.Get("group").(string)
+	arn := d.Get("policy_arn").(string)
+
+	_, err := conn.AttachGroupPolicy(&iam.AttachGroupPolicyInput{
+		GroupName: aws.String(group),
+		PolicyARN: aws.String(arn),
+	})
+	if err != nil {
+		return fmt.Errorf("[WARN] Error attaching policy %s to IAM group %s: %v", arn, group, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", group, arn))
+	return resourceAwsIamGroupPolicyAttachmentRead(d, meta)
+}
+
+func resourceAwsIamGroupPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	group := d.Get("group").(string)
+	arn := d.Get("policy_arn").(string)
+
+	attached, err := iamGroupHasAttachedPolicy(conn, group, arn)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoSuchEntity" {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if !attached {
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceAwsIamGroupPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	group := d.Get("group").(string)
+	arn := d.Get("policy_arn").(string)
+
+	_, err := conn.DetachGroupPolicy(&iam.DetachGroupPolicyInput{
+		GroupName: aws.String(group),
+		PolicyARN: aws.String(arn),
+	})
+	if err != nil {
+		return fmt.Errorf("[WARN] Error detaching policy %s from IAM group %s: %v", arn, group, err)
+	}
+
+	return nil
+}
+
+// iamGroupHasAttachedPolicy reports whether arn is among the policies
+// ListAttachedGroupPolicies returns for group, leaving every other
+// attachment on the group untouched. It pages through the full result set
+// so a policy sitting beyond the first page isn't reported as detached.
+func iamGroupHasAttachedPolicy(conn *iam.IAM, group string, arn string) (bool, error) {
+	input := &iam.ListAttachedGroupPoliciesInput{
+		GroupName: aws.String(group),
+	}
+
+	for {
+		out, err := conn.ListAttachedGroupPolicies(input)
+		if err != nil {
+			return false, err
+		}
+
+		for _, p := range out.AttachedPolicies {
+			if *p.PolicyARN == arn {
+				return true, nil
+			}
+		}
+
+		if out.IsTruncated == nil || !*out.IsTruncated {
+			break
+		}
+		input.Marker = out.Marker
+	}
+
+	return false, nil
+}
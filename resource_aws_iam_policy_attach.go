@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -15,6 +16,9 @@ func resourceAwsIamPolicyAttachment() *schema.Resource {
 		Read:   resourceAwsIamPolicyAttachmentRead,
 		Update: resourceAwsIamPolicyAttachmentUpdate,
 		Delete: resourceAwsIamPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamPolicyAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -50,7 +54,8 @@ func resourceAwsIamPolicyAttachment() *schema.Resource {
 }
 
 func resourceAwsIamPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	client := meta.(*AWSClient)
+	conn := client.iamconn
 
 	name := d.Get("name").(string)
 	arn := d.Get("policy_arn").(string)
@@ -60,23 +65,58 @@ func resourceAwsIamPolicyAttachmentCreate(d *schema.ResourceData, meta interface
 
 	if users == nil && roles == nil && groups == nil {
 		return fmt.Errorf("[WARN] No Users, Roles, or Groups specified for %s", name)
-	} else {
-		var userErr, roleErr, groupErr error
-		if users != nil {
-			userErr = attachPolicyToUsers(conn, users, arn)
-		}
-		if roles != nil {
-			roleErr = attachPolicyToRoles(conn, roles, arn)
-		}
-		if groups != nil {
-			groupErr = attachPolicyToGroups(conn, groups, arn)
-		}
-		if userErr != nil || roleErr != nil || groupErr != nil {
-			return fmt.Errorf("[WARN] Error attaching policy with IAM Policy Attach (%s), error:\n users - %v\n roles - %v\n groups - %v", name, userErr, roleErr, groupErr)
-		}
 	}
-	d.SetId(d.Get("name").(string))
-	return resourceAwsIamPolicyAttachmentRead(d, meta)
+
+	var userErr, roleErr, groupErr error
+	if users != nil {
+		userErr = attachPolicyToUsers(conn, users, arn, client.iamParallelism)
+	}
+	if roles != nil {
+		roleErr = attachPolicyToRoles(conn, roles, arn, client.iamParallelism)
+	}
+	if groups != nil {
+		groupErr = attachPolicyToGroups(conn, groups, arn, client.iamParallelism)
+	}
+
+	// Set the ID and read back whatever actually landed even if one of
+	// the fan-outs above failed, so principals that did attach
+	// successfully are still recorded in state.
+	d.SetId(name)
+	if readErr := resourceAwsIamPolicyAttachmentRead(d, meta); readErr != nil {
+		return readErr
+	}
+
+	if userErr != nil || roleErr != nil || groupErr != nil {
+		return fmt.Errorf("[WARN] Error attaching policy with IAM Policy Attach (%s), error:\n users - %v\n roles - %v\n groups - %v", name, userErr, roleErr, groupErr)
+	}
+	return nil
+}
+
+func resourceAwsIamPolicyAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "|", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected <name>|<policy_arn>", d.Id())
+	}
+	name, arn := parts[0], parts[1]
+
+	d.SetId(name)
+	d.Set("name", name)
+	d.Set("policy_arn", arn)
+
+	// The subsequent Read only reports drift against principals already
+	// tracked in state, so populate users/roles/groups with what IAM
+	// actually reports now - otherwise a freshly imported resource has
+	// nothing tracked yet and Read's intersection comes back empty.
+	conn := meta.(*AWSClient).iamconn
+	users, roles, groups, err := listEntitiesForPolicy(conn, arn)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing entities for IAM Policy Attach (%s): %s", name, err)
+	}
+	d.Set("users", users)
+	d.Set("roles", roles)
+	d.Set("groups", groups)
+
+	return []*schema.ResourceData{d}, nil
 }
 
 func resourceAwsIamPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
@@ -98,62 +138,107 @@ func resourceAwsIamPolicyAttachmentRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	policyEntities, err := conn.ListEntitiesForPolicy(&iam.ListEntitiesForPolicyInput{
-		PolicyARN: aws.String(arn),
-	})
-
+	ul, rl, gl, err := listEntitiesForPolicy(conn, arn)
 	if err != nil {
-		return err
+		return fmt.Errorf("[WARN] Error listing entities for IAM Policy Attach (%s): %s", name, err)
 	}
 
-	ul := make([]string, 0, len(policyEntities.PolicyUsers))
-	rl := make([]string, 0, len(policyEntities.PolicyRoles))
-	gl := make([]string, 0, len(policyEntities.PolicyGroups))
+	// Only report drift for principals that were previously tracked in
+	// state. Attachments made to this policy by other configs or tools
+	// are left alone instead of being ripped out on the next apply.
+	userErr := d.Set("users", intersectStringSlice(d.Get("users").(*schema.Set), ul))
+	roleErr := d.Set("roles", intersectStringSlice(d.Get("roles").(*schema.Set), rl))
+	groupErr := d.Set("groups", intersectStringSlice(d.Get("groups").(*schema.Set), gl))
 
-	for _, u := range policyEntities.PolicyUsers {
-		ul = append(ul, *u.UserName)
+	if userErr != nil || roleErr != nil || groupErr != nil {
+		return fmt.Errorf("[WARN} Error setting user, role, or group list from IAM Policy Attach (%s):\n user error - %s\n role error - %s\n group error - %s", name, userErr, roleErr, groupErr)
 	}
 
-	for _, r := range policyEntities.PolicyRoles {
-		rl = append(rl, *r.RoleName)
+	return nil
+}
+
+// listEntitiesForPolicy returns every user, role, and group the policy at
+// arn is attached to, paging through ListEntitiesForPolicy until
+// IsTruncated is false so attachments beyond the first page (the API caps
+// a page at 100 principals) aren't silently dropped.
+func listEntitiesForPolicy(conn *iam.IAM, arn string) (users, roles, groups []string, err error) {
+	input := &iam.ListEntitiesForPolicyInput{
+		PolicyARN: aws.String(arn),
 	}
 
-	for _, g := range policyEntities.PolicyGroups {
-		gl = append(gl, *g.GroupName)
+	for {
+		out, err := conn.ListEntitiesForPolicy(input)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		for _, u := range out.PolicyUsers {
+			users = append(users, *u.UserName)
+		}
+		for _, r := range out.PolicyRoles {
+			roles = append(roles, *r.RoleName)
+		}
+		for _, g := range out.PolicyGroups {
+			groups = append(groups, *g.GroupName)
+		}
+
+		if out.IsTruncated == nil || !*out.IsTruncated {
+			break
+		}
+		input.Marker = out.Marker
 	}
 
-	userErr := d.Set("users", ul)
-	roleErr := d.Set("roles", rl)
-	groupErr := d.Set("groups", gl)
+	return users, roles, groups, nil
+}
 
-	if userErr != nil || roleErr != nil || groupErr != nil {
-		return fmt.Errorf("[WARN} Error setting user, role, or group list from IAM Policy Attach (%s):\n user error - %s\n role error - %s\n group error - %s", name, userErr, roleErr, groupErr)
+// intersectStringSlice returns the elements of current that are also
+// present in tracked, preserving state for principals Terraform already
+// knows about while ignoring everything else IAM reports.
+func intersectStringSlice(tracked *schema.Set, current []string) []string {
+	currentSet := make(map[string]bool, len(current))
+	for _, c := range current {
+		currentSet[c] = true
 	}
 
-	return nil
+	result := make([]string, 0, tracked.Len())
+	for _, t := range tracked.List() {
+		name := t.(string)
+		if currentSet[name] {
+			result = append(result, name)
+		}
+	}
+
+	return result
 }
 func resourceAwsIamPolicyAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	client := meta.(*AWSClient)
+	conn := client.iamconn
 	name := d.Get("name").(string)
 	var userErr, roleErr, groupErr error
 
 	if d.HasChange("users") {
-		userErr = updateUsers(conn, d, meta)
+		userErr = updateUsers(conn, d, client.iamParallelism)
 	}
 	if d.HasChange("roles") {
-		roleErr = updateRoles(conn, d, meta)
+		roleErr = updateRoles(conn, d, client.iamParallelism)
 	}
 	if d.HasChange("groups") {
-		groupErr = updateGroups(conn, d, meta)
+		groupErr = updateGroups(conn, d, client.iamParallelism)
+	}
+
+	if readErr := resourceAwsIamPolicyAttachmentRead(d, meta); readErr != nil {
+		return readErr
 	}
+
 	if userErr != nil || roleErr != nil || groupErr != nil {
 		return fmt.Errorf("[WARN] Error updating user, role, or group list from IAM Policy Attach (%s):\n user error - %s\n role error - %s\n group error - %s", name, userErr, roleErr, groupErr)
 	}
-	return resourceAwsIamPolicyAttachmentRead(d, meta)
+	return nil
 }
 
 func resourceAwsIamPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	client := meta.(*AWSClient)
+	conn := client.iamconn
 	name := d.Get("name").(string)
 	arn := d.Get("policy_arn").(string)
 	users := expandStringList(d.Get("users").(*schema.Set).List())
@@ -162,56 +247,20 @@ func resourceAwsIamPolicyAttachmentDelete(d *schema.ResourceData, meta interface
 
 	var userErr, roleErr, groupErr error
 	if users != nil {
-		userErr = detachPolicyFromUsers(conn, users, arn)
+		userErr = detachPolicyFromUsers(conn, users, arn, client.iamParallelism)
 	}
 	if roles != nil {
-		roleErr = detachPolicyFromRoles(conn, roles, arn)
+		roleErr = detachPolicyFromRoles(conn, roles, arn, client.iamParallelism)
 	}
 	if groups != nil {
-		groupErr = detachPolicyFromGroups(conn, groups, arn)
+		groupErr = detachPolicyFromGroups(conn, groups, arn, client.iamParallelism)
 	}
 	if userErr != nil || roleErr != nil || groupErr != nil {
 		return fmt.Errorf("[WARN] Error removing user, role, or group list from IAM Policy Detach (%s), error:\n users - %v\n roles - %v\n groups - %v", name, userErr, roleErr, groupErr)
 	}
 	return nil
 }
-func attachPolicyToUsers(conn *iam.IAM, users []*string, arn string) error {
-	for _, u := range users {
-		_, err := conn.AttachUserPolicy(&iam.AttachUserPolicyInput{
-			UserName:  u,
-			PolicyARN: aws.String(arn),
-		})
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-func attachPolicyToRoles(conn *iam.IAM, roles []*string, arn string) error {
-	for _, r := range roles {
-		_, err := conn.AttachRolePolicy(&iam.AttachRolePolicyInput{
-			RoleName:  r,
-			PolicyARN: aws.String(arn),
-		})
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-func attachPolicyToGroups(conn *iam.IAM, groups []*string, arn string) error {
-	for _, g := range groups {
-		_, err := conn.AttachGroupPolicy(&iam.AttachGroupPolicyInput{
-			GroupName: g,
-			PolicyARN: aws.String(arn),
-		})
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-func updateUsers(conn *iam.IAM, d *schema.ResourceData, meta interface{}) error {
+func updateUsers(conn *iam.IAM, d *schema.ResourceData, parallelism int) error {
 	arn := d.Get("policy_arn").(string)
 	o, n := d.GetChange("users")
 	if o == nil {
@@ -225,15 +274,11 @@ func updateUsers(conn *iam.IAM, d *schema.ResourceData, meta interface{}) error
 	remove := expandStringList(os.Difference(ns).List())
 	add := expandStringList(ns.Difference(os).List())
 
-	if rErr := detachPolicyFromUsers(conn, remove, arn); rErr != nil {
-		return rErr
-	}
-	if aErr := attachPolicyToUsers(conn, add, arn); aErr != nil {
-		return aErr
-	}
-	return nil
+	rErr := detachPolicyFromUsers(conn, remove, arn, parallelism)
+	aErr := attachPolicyToUsers(conn, add, arn, parallelism)
+	return composeErrors([]error{rErr, aErr})
 }
-func updateRoles(conn *iam.IAM, d *schema.ResourceData, meta interface{}) error {
+func updateRoles(conn *iam.IAM, d *schema.ResourceData, parallelism int) error {
 	arn := d.Get("policy_arn").(string)
 	o, n := d.GetChange("roles")
 	if o == nil {
@@ -247,15 +292,11 @@ func updateRoles(conn *iam.IAM, d *schema.ResourceData, meta interface{}) error
 	remove := expandStringList(os.Difference(ns).List())
 	add := expandStringList(ns.Difference(os).List())
 
-	if rErr := detachPolicyFromRoles(conn, remove, arn); rErr != nil {
-		return rErr
-	}
-	if aErr := attachPolicyToRoles(conn, add, arn); aErr != nil {
-		return aErr
-	}
-	return nil
+	rErr := detachPolicyFromRoles(conn, remove, arn, parallelism)
+	aErr := attachPolicyToRoles(conn, add, arn, parallelism)
+	return composeErrors([]error{rErr, aErr})
 }
-func updateGroups(conn *iam.IAM, d *schema.ResourceData, meta interface{}) error {
+func updateGroups(conn *iam.IAM, d *schema.ResourceData, parallelism int) error {
 	arn := d.Get("policy_arn").(string)
 	o, n := d.GetChange("groups")
 	if o == nil {
@@ -269,48 +310,7 @@ func updateGroups(conn *iam.IAM, d *schema.ResourceData, meta interface{}) error
 	remove := expandStringList(os.Difference(ns).List())
 	add := expandStringList(ns.Difference(os).List())
 
-	if rErr := detachPolicyFromGroups(conn, remove, arn); rErr != nil {
-		return rErr
-	}
-	if aErr := attachPolicyToGroups(conn, add, arn); aErr != nil {
-		return aErr
-	}
-	return nil
-
-}
-func detachPolicyFromUsers(conn *iam.IAM, users []*string, arn string) error {
-	for _, u := range users {
-		_, err := conn.DetachUserPolicy(&iam.DetachUserPolicyInput{
-			UserName:  u,
-			PolicyARN: aws.String(arn),
-		})
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-func detachPolicyFromRoles(conn *iam.IAM, roles []*string, arn string) error {
-	for _, r := range roles {
-		_, err := conn.DetachRolePolicy(&iam.DetachRolePolicyInput{
-			RoleName:  r,
-			PolicyARN: aws.String(arn),
-		})
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-func detachPolicyFromGroups(conn *iam.IAM, groups []*string, arn string) error {
-	for _, g := range groups {
-		_, err := conn.DetachGroupPolicy(&iam.DetachGroupPolicyInput{
-			GroupName: g,
-			PolicyARN: aws.String(arn),
-		})
-		if err != nil {
-			return err
-		}
-	}
-	return nil
+	rErr := detachPolicyFromGroups(conn, remove, arn, parallelism)
+	aErr := attachPolicyToGroups(conn, add, arn, parallelism)
+	return composeErrors([]error{rErr, aErr})
 }
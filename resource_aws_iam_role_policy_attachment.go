@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsIamRolePolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIamRolePolicyAttachmentCreate,
+		Read:   resourceAwsIamRolePolicyAttachmentRead,
+		Delete: resourceAwsIamRolePolicyAttachmentDelete,
+
+		Schema: map[string]*schema.Schema{
+			"role": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy_arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIamRolePolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	role := d.Get("role").(string)
+	arn := d.Get("policy_arn").(string)
+
+	_, err := conn.AttachRolePolicy(&iam.AttachRolePolicyInput{
+		RoleName:  aws.String(role),
+		PolicyARN: aws.String(arn),
+	})
+	if err != nil {
+		return fmt.Errorf("[WARN] Error attaching policy %s to IAM role %s: %v", arn, role, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", role, arn))
+	return resourceAwsIamRolePolicyAttachmentRead(d, meta)
+}
+
+func resourceAwsIamRolePolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	role := d.Get("role").(string)
+	arn := d.Get("policy_arn").(string)
+
+	attached, err := iamRoleHasAttachedPolicy(conn, role, arn)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoSuchEntity" {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if !attached {
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceAwsIamRolePolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	role := d.Get("role").(string)
+	arn := d.Get("policy_arn").(string)
+
+	_, err := conn.DetachRolePolicy(&iam.DetachRolePolicyInput{
+		RoleName:  aws.String(role),
+		PolicyARN: aws.String(arn),
+	})
+	if err != nil {
+		return fmt.Errorf("[WARN] Error detaching policy %s from IAM role %s: %v", arn, role, err)
+	}
+
+	return nil
+}
+
+// iamRoleHasAttachedPolicy reports whether arn is among the policies
+// ListAttachedRolePolicies returns for role, leaving every other
+// attachment on the role untouched. It pages through the full result set
+// so a policy sitting beyond the first page isn't reported as detached.
+func iamRoleHasAttachedPolicy(conn *iam.IAM, role string, arn string) (bool, error) {
+	input := &iam.ListAttachedRolePoliciesInput{
+		RoleName: aws.String(role),
+	}
+
+	for {
+		out, err := conn.ListAttachedRolePolicies(input)
+		if err != nil {
+			return false, err
+		}
+
+		for _, p := range out.AttachedPolicies {
+			if *p.PolicyARN == arn {
+				return true, nil
+			}
+		}
+
+		if out.IsTruncated == nil || !*out.IsTruncated {
+			break
+		}
+		input.Marker = out.Marker
+	}
+
+	return false, nil
+}
@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsIamUserPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIamUserPolicyAttachmentCreate,
+		Read:   resourceAwsIamUserPolicyAttachmentRead,
+		Delete: resourceAwsIamUserPolicyAttachmentDelete,
+
+		Schema: map[string]*schema.Schema{
+			"user": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy_arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIamUserPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	user := d.Get("user").(string)
+	arn := d.Get("policy_arn").(string)
+
+	_, err := conn.AttachUserPolicy(&iam.AttachUserPolicyInput{
+		UserName:  aws.String(user),
+		PolicyARN: aws.String(arn),
+	})
+	if err != nil {
+		return fmt.Errorf("[WARN] Error attaching policy %s to IAM user %s: %v", arn, user, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", user, arn))
+	return resourceAwsIamUserPolicyAttachmentRead(d, meta)
+}
+
+func resourceAwsIamUserPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	user := d.Get("user").(string)
+	arn := d.Get("policy_arn").(string)
+
+	attached, err := iamUserHasAttachedPolicy(conn, user, arn)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoSuchEntity" {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if !attached {
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceAwsIamUserPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	user := d.Get("user").(string)
+	arn := d.Get("policy_arn").(string)
+
+	_, err := conn.DetachUserPolicy(&iam.DetachUserPolicyInput{
+		UserName:  aws.String(user),
+		PolicyARN: aws.String(arn),
+	})
+	if err != nil {
+		return fmt.Errorf("[WARN] Error detaching policy %s from IAM user %s: %v", arn, user, err)
+	}
+
+	return nil
+}
+
+// iamUserHasAttachedPolicy reports whether arn is among the policies
+// ListAttachedUserPolicies returns for user, leaving every other
+// attachment on the user untouched. It pages through the full result set
+// so a policy sitting beyond the first page isn't reported as detached.
+func iamUserHasAttachedPolicy(conn *iam.IAM, user string, arn string) (bool, error) {
+	input := &iam.ListAttachedUserPoliciesInput{
+		UserName: aws.String(user),
+	}
+
+	for {
+		out, err := conn.ListAttachedUserPolicies(input)
+		if err != nil {
+			return false, err
+		}
+
+		for _, p := range out.AttachedPolicies {
+			if *p.PolicyARN == arn {
+				return true, nil
+			}
+		}
+
+		if out.IsTruncated == nil || !*out.IsTruncated {
+			break
+		}
+		input.Marker = out.Marker
+	}
+
+	return false, nil
+}